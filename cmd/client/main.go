@@ -0,0 +1,40 @@
+// Command client is a small CLI that looks up the weather for a zipcode
+// through the gRPC transport, for manual testing against a running server.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/fbonareis/goexpert-deploy-cloud-run/proto/weatherpb"
+)
+
+func main() {
+	addr := flag.String("addr", "localhost:50051", "gRPC server address")
+	zipCode := flag.String("zipcode", "", "zipcode to look up")
+	flag.Parse()
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("failed to dial %s: %v", *addr, err)
+	}
+	defer conn.Close()
+
+	client := weatherpb.NewWeatherServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := client.GetWeatherByZip(ctx, &weatherpb.RequestZip{ZipCode: *zipCode})
+	if err != nil {
+		log.Fatalf("GetWeatherByZip failed: %v", err)
+	}
+
+	fmt.Printf("%+v\n", resp)
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/fbonareis/goexpert-deploy-cloud-run/internal/weather"
+	"github.com/fbonareis/goexpert-deploy-cloud-run/proto/weatherpb"
+)
+
+const bufSize = 1024 * 1024
+
+type mockZipCodeService struct {
+	mock.Mock
+}
+
+func (m *mockZipCodeService) GetLocation(ctx context.Context, zipCode, country string) (*weather.LocationResponse, error) {
+	args := m.Called(ctx, zipCode, country)
+	return args.Get(0).(*weather.LocationResponse), args.Error(1)
+}
+
+type mockWeatherService struct {
+	mock.Mock
+}
+
+func (m *mockWeatherService) GetWeatherFromCity(ctx context.Context, city string) (*weather.WeatherResponse, error) {
+	args := m.Called(ctx, city)
+	return args.Get(0).(*weather.WeatherResponse), args.Error(1)
+}
+
+// dialClient starts a real gRPC server backed by the given mocks on an
+// in-memory listener and returns a client dialed against it, end-to-end.
+func dialClient(t *testing.T, zipCodeService weather.ZipCodeService, weatherService weather.WeatherService) weatherpb.WeatherServiceClient {
+	t.Helper()
+
+	listener := bufconn.Listen(bufSize)
+
+	server := grpc.NewServer()
+	weatherpb.RegisterWeatherServiceServer(server, weather.NewWeatherGRPCServer(zipCodeService, weatherService))
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	dialer := func(ctx context.Context, _ string) (net.Conn, error) {
+		return listener.Dial()
+	}
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(dialer),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return weatherpb.NewWeatherServiceClient(conn)
+}
+
+func TestClient_GetWeatherByZip_Success(t *testing.T) {
+	mockZip := new(mockZipCodeService)
+	mockWeather := new(mockWeatherService)
+
+	testZipCode := "12345678"
+	mockZip.On("GetLocation", mock.Anything, testZipCode, "BR").Return(&weather.LocationResponse{City: "TestCity"}, nil)
+
+	expectedWeather := &weather.WeatherResponse{}
+	expectedWeather.Current.TempC = 25.0
+	mockWeather.On("GetWeatherFromCity", mock.Anything, "TestCity").Return(expectedWeather, nil)
+
+	client := dialClient(t, mockZip, mockWeather)
+
+	resp, err := client.GetWeatherByZip(context.Background(), &weatherpb.RequestZip{ZipCode: testZipCode})
+	assert.NoError(t, err)
+	assert.Equal(t, 25.0, resp.TempC)
+
+	mockZip.AssertExpectations(t)
+	mockWeather.AssertExpectations(t)
+}
+
+func TestClient_GetWeatherByZip_InvalidZipCode(t *testing.T) {
+	mockZip := new(mockZipCodeService)
+	mockWeather := new(mockWeatherService)
+
+	invalidZipCode := "123"
+	mockZip.On("GetLocation", mock.Anything, invalidZipCode, "BR").Return(&weather.LocationResponse{}, weather.ErrInvalidZipCode)
+
+	client := dialClient(t, mockZip, mockWeather)
+
+	_, err := client.GetWeatherByZip(context.Background(), &weatherpb.RequestZip{ZipCode: invalidZipCode})
+	assert.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	mockZip.AssertExpectations(t)
+}
+
+func TestClient_GetWeatherByZip_NotFound(t *testing.T) {
+	mockZip := new(mockZipCodeService)
+	mockWeather := new(mockWeatherService)
+
+	testZipCode := "12345678"
+	mockZip.On("GetLocation", mock.Anything, testZipCode, "BR").Return(&weather.LocationResponse{Erro: true}, nil)
+
+	client := dialClient(t, mockZip, mockWeather)
+
+	_, err := client.GetWeatherByZip(context.Background(), &weatherpb.RequestZip{ZipCode: testZipCode})
+	assert.Error(t, err)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	mockZip.AssertExpectations(t)
+}
@@ -0,0 +1,175 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+const cacheShardCount = 16
+
+type cacheItem struct {
+	value     interface{}
+	expiresAt time.Time
+}
+
+type cacheShard struct {
+	mu    sync.RWMutex
+	items map[string]cacheItem
+}
+
+// ttlCache is a sharded, in-memory cache with per-entry expiry and a
+// background janitor that evicts stale entries, used to avoid hammering
+// upstream APIs under Cloud Run burst traffic.
+type ttlCache struct {
+	shards [cacheShardCount]*cacheShard
+	ttl    time.Duration
+}
+
+func newTTLCache(ttl time.Duration) *ttlCache {
+	c := &ttlCache{ttl: ttl}
+	for i := range c.shards {
+		c.shards[i] = &cacheShard{items: make(map[string]cacheItem)}
+	}
+	go c.janitor()
+	return c
+}
+
+func (c *ttlCache) shardFor(key string) *cacheShard {
+	return c.shards[fnv32(key)%cacheShardCount]
+}
+
+func (c *ttlCache) get(key string) (interface{}, bool) {
+	shard := c.shardFor(key)
+	shard.mu.RLock()
+	defer shard.mu.RUnlock()
+
+	item, ok := shard.items[key]
+	if !ok || time.Now().After(item.expiresAt) {
+		return nil, false
+	}
+	return item.value, true
+}
+
+func (c *ttlCache) set(key string, value interface{}) {
+	shard := c.shardFor(key)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	shard.items[key] = cacheItem{value: value, expiresAt: time.Now().Add(c.ttl)}
+}
+
+// janitor periodically sweeps every shard for expired entries so the cache
+// doesn't grow unbounded between reads of the same key.
+func (c *ttlCache) janitor() {
+	ticker := time.NewTicker(c.ttl)
+	defer ticker.Stop()
+
+	for now := range ticker.C {
+		for _, shard := range c.shards {
+			shard.mu.Lock()
+			for key, item := range shard.items {
+				if now.After(item.expiresAt) {
+					delete(shard.items, key)
+				}
+			}
+			shard.mu.Unlock()
+		}
+	}
+}
+
+// fnv32 is a tiny non-cryptographic hash used to pick a shard for a key.
+func fnv32(s string) uint32 {
+	const prime32 = 16777619
+	hash := uint32(2166136261)
+	for i := 0; i < len(s); i++ {
+		hash *= prime32
+		hash ^= uint32(s[i])
+	}
+	return hash
+}
+
+// CachedZipCodeService wraps a ZipCodeService with a TTL cache keyed by
+// zipcode, since a CEP's resolved city rarely changes.
+type CachedZipCodeService struct {
+	inner ZipCodeService
+	cache *ttlCache
+}
+
+func NewCachedZipCodeService(inner ZipCodeService, ttl time.Duration) *CachedZipCodeService {
+	return &CachedZipCodeService{inner: inner, cache: newTTLCache(ttl)}
+}
+
+func (s *CachedZipCodeService) GetLocation(ctx context.Context, zipCode, country string) (*LocationResponse, error) {
+	key := country + ":" + zipCode
+	if cached, ok := s.cache.get(key); ok {
+		globalMetrics.IncCacheHit()
+		return cached.(*LocationResponse), nil
+	}
+	globalMetrics.IncCacheMiss()
+
+	location, err := s.inner.GetLocation(ctx, zipCode, country)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cache.set(key, location)
+	return location, nil
+}
+
+// CachedWeatherService wraps a WeatherService with a TTL cache keyed by
+// normalized city name.
+type CachedWeatherService struct {
+	inner WeatherService
+	cache *ttlCache
+}
+
+func NewCachedWeatherService(inner WeatherService, ttl time.Duration) *CachedWeatherService {
+	return &CachedWeatherService{inner: inner, cache: newTTLCache(ttl)}
+}
+
+func (s *CachedWeatherService) GetWeatherFromCity(ctx context.Context, city string) (*WeatherResponse, error) {
+	key := normalizeCityKey(city)
+	if cached, ok := s.cache.get(key); ok {
+		globalMetrics.IncCacheHit()
+		return cached.(*WeatherResponse), nil
+	}
+	globalMetrics.IncCacheMiss()
+
+	weather, err := s.inner.GetWeatherFromCity(ctx, city)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cache.set(key, weather)
+	return weather, nil
+}
+
+func normalizeCityKey(city string) string {
+	return strings.ToLower(removeAccents(city))
+}
+
+// GetWeatherByCoordinates forwards to the inner service if it supports
+// coordinate lookups, caching the result keyed by lat/lon.
+func (s *CachedWeatherService) GetWeatherByCoordinates(ctx context.Context, lat, lon float64) (*WeatherResponse, error) {
+	coordService, ok := s.inner.(CoordinateWeatherService)
+	if !ok {
+		return nil, ErrCoordinatesNotSupported
+	}
+
+	key := fmt.Sprintf("coord:%f,%f", lat, lon)
+	if cached, ok := s.cache.get(key); ok {
+		globalMetrics.IncCacheHit()
+		return cached.(*WeatherResponse), nil
+	}
+	globalMetrics.IncCacheMiss()
+
+	weather, err := coordService.GetWeatherByCoordinates(ctx, lat, lon)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cache.set(key, weather)
+	return weather, nil
+}
@@ -0,0 +1,110 @@
+package weather
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCachedZipCodeService_CachesByZipCode(t *testing.T) {
+	mockZipService := new(MockZipCodeService)
+	testZipCode := "12345678"
+	expectedLocation := &LocationResponse{City: "TestCity"}
+	mockZipService.On("GetLocation", mock.Anything, testZipCode, "BR").Return(expectedLocation, nil).Once()
+
+	cached := NewCachedZipCodeService(mockZipService, time.Minute)
+
+	for i := 0; i < 3; i++ {
+		location, err := cached.GetLocation(context.Background(), testZipCode, "BR")
+		assert.NoError(t, err)
+		assert.Equal(t, expectedLocation, location)
+	}
+
+	mockZipService.AssertExpectations(t)
+}
+
+func TestCachedWeatherService_CachesByNormalizedCity(t *testing.T) {
+	tests := []struct {
+		name         string
+		lookupCities []string
+	}{
+		{name: "same casing", lookupCities: []string{"Sao Paulo", "Sao Paulo"}},
+		{name: "different casing and accents", lookupCities: []string{"São Paulo", "sao paulo", "SAO PAULO"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockWeatherService := new(MockWeatherService)
+			expectedWeather := &WeatherResponse{}
+			expectedWeather.Current.TempC = 25.0
+			mockWeatherService.On("GetWeatherFromCity", mock.Anything, tt.lookupCities[0]).Return(expectedWeather, nil).Once()
+
+			cached := NewCachedWeatherService(mockWeatherService, time.Minute)
+
+			for _, city := range tt.lookupCities {
+				weather, err := cached.GetWeatherFromCity(context.Background(), city)
+				assert.NoError(t, err)
+				assert.Equal(t, expectedWeather, weather)
+			}
+
+			mockWeatherService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCachedWeatherService_CachesByCoordinates(t *testing.T) {
+	mockWeatherService := new(MockWeatherService)
+	expectedWeather := &WeatherResponse{}
+	expectedWeather.Current.TempC = 25.0
+	mockWeatherService.On("GetWeatherByCoordinates", mock.Anything, -23.5505, -46.6333).Return(expectedWeather, nil).Once()
+
+	cached := NewCachedWeatherService(mockWeatherService, time.Minute)
+
+	for i := 0; i < 3; i++ {
+		weather, err := cached.GetWeatherByCoordinates(context.Background(), -23.5505, -46.6333)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedWeather, weather)
+	}
+
+	mockWeatherService.AssertExpectations(t)
+}
+
+func TestCachedWeatherService_CoordinatesNotSupported(t *testing.T) {
+	mockWeatherService := new(MockWeatherService)
+	cached := NewCachedWeatherService(mockWeatherService, time.Minute)
+
+	cached.inner = &unsupportedWeatherService{}
+
+	_, err := cached.GetWeatherByCoordinates(context.Background(), -23.5505, -46.6333)
+	assert.ErrorIs(t, err, ErrCoordinatesNotSupported)
+}
+
+// unsupportedWeatherService implements WeatherService but not
+// CoordinateWeatherService, used to exercise the fallback error path.
+type unsupportedWeatherService struct{}
+
+func (s *unsupportedWeatherService) GetWeatherFromCity(ctx context.Context, city string) (*WeatherResponse, error) {
+	return nil, nil
+}
+
+func TestCachedZipCodeService_ExpiresAfterTTL(t *testing.T) {
+	mockZipService := new(MockZipCodeService)
+	testZipCode := "12345678"
+	expectedLocation := &LocationResponse{City: "TestCity"}
+	mockZipService.On("GetLocation", mock.Anything, testZipCode, "BR").Return(expectedLocation, nil).Twice()
+
+	cached := NewCachedZipCodeService(mockZipService, time.Millisecond)
+
+	_, err := cached.GetLocation(context.Background(), testZipCode, "BR")
+	assert.NoError(t, err)
+
+	time.Sleep(5 * time.Millisecond)
+
+	_, err = cached.GetLocation(context.Background(), testZipCode, "BR")
+	assert.NoError(t, err)
+
+	mockZipService.AssertExpectations(t)
+}
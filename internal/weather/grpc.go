@@ -0,0 +1,67 @@
+package weather
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/fbonareis/goexpert-deploy-cloud-run/proto/weatherpb"
+)
+
+// WeatherGRPCServer exposes GetWeather over gRPC, sharing the same core
+// lookup logic and ZipCodeService/WeatherService interfaces as CreateHandler.
+type WeatherGRPCServer struct {
+	weatherpb.UnimplementedWeatherServiceServer
+	zipCodeService ZipCodeService
+	weatherService WeatherService
+}
+
+func NewWeatherGRPCServer(zipCodeService ZipCodeService, weatherService WeatherService) *WeatherGRPCServer {
+	return &WeatherGRPCServer{
+		zipCodeService: zipCodeService,
+		weatherService: weatherService,
+	}
+}
+
+func (s *WeatherGRPCServer) GetWeatherByZip(ctx context.Context, req *weatherpb.RequestZip) (*weatherpb.LocationWeatherResponse, error) {
+	country := req.GetCountry()
+	if country == "" {
+		country = "BR"
+	}
+
+	response, err := GetWeather(ctx, s.zipCodeService, s.weatherService, req.GetZipCode(), country)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	return &weatherpb.LocationWeatherResponse{
+		TempC:      response.TempC,
+		TempF:      response.TempF,
+		TempK:      response.TempK,
+		FeelsLikeC: response.FeelsLikeC,
+		Humidity:   response.Humidity,
+		PressureMB: response.PressureMB,
+		WindKPH:    response.WindKPH,
+		CloudPct:   response.CloudPct,
+	}, nil
+}
+
+// grpcError mirrors handleError's HTTP status mapping as gRPC status codes.
+func grpcError(err error) error {
+	switch {
+	case errors.Is(err, ErrInvalidZipCode):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ErrCanNotFindZipCode):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, ErrInvalidCoordinates):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ErrCoordinatesNotSupported):
+		return status.Error(codes.Unimplemented, err.Error())
+	case errors.Is(err, ErrUpstreamUnavailable):
+		return status.Error(codes.Unavailable, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
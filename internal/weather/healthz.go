@@ -0,0 +1,24 @@
+package weather
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// HealthzHandler builds the HTTP handler for the /healthz endpoint, reporting
+// the circuit breaker state ("closed", "open", "half-open") for every
+// upstream registered via NewResilientZipCodeService/NewResilientWeatherService.
+func HealthzHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		breakersMu.RLock()
+		response := make(map[string]string, len(breakers))
+		for name, cb := range breakers {
+			response[name] = breakerStateString(cb.State())
+		}
+		breakersMu.RUnlock()
+
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package weather
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+)
+
+var globalMetrics = &cacheMetrics{}
+
+// cacheMetrics tracks cache hit/miss counters across every CachedZipCodeService
+// and CachedWeatherService instance, surfaced via MetricsHandler.
+type cacheMetrics struct {
+	hits   int64
+	misses int64
+}
+
+func (m *cacheMetrics) IncCacheHit()  { atomic.AddInt64(&m.hits, 1) }
+func (m *cacheMetrics) IncCacheMiss() { atomic.AddInt64(&m.misses, 1) }
+
+type metricsResponse struct {
+	CacheHits   int64 `json:"cache_hits"`
+	CacheMisses int64 `json:"cache_misses"`
+}
+
+// MetricsHandler builds the HTTP handler for the /metrics endpoint.
+func MetricsHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		response := metricsResponse{
+			CacheHits:   atomic.LoadInt64(&globalMetrics.hits),
+			CacheMisses: atomic.LoadInt64(&globalMetrics.misses),
+		}
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package weather
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricsHandler(t *testing.T) {
+	globalMetrics.IncCacheHit()
+	globalMetrics.IncCacheMiss()
+
+	req, err := http.NewRequest("GET", "/metrics", nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	MetricsHandler().ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var response metricsResponse
+	assert.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+	assert.GreaterOrEqual(t, response.CacheHits, int64(1))
+	assert.GreaterOrEqual(t, response.CacheMisses, int64(1))
+}
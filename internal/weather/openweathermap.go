@@ -0,0 +1,153 @@
+package weather
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const baseURLopenWeatherMap = "http://api.openweathermap.org"
+
+func init() {
+	RegisterWeatherProvider("openweathermap", func() WeatherService {
+		return &OpenWeatherMapService{}
+	})
+}
+
+// OpenWeatherMapService is a WeatherService backed by OpenWeatherMap's geocoding and
+// current weather endpoints. It resolves a city name to coordinates first, since
+// OpenWeatherMap's current weather endpoint is keyed by lat/lon rather than city name.
+type OpenWeatherMapService struct{}
+
+type openWeatherMapGeoResult struct {
+	Lat float64 `json:"lat"`
+	Lon float64 `json:"lon"`
+}
+
+type openWeatherMapWeatherResponse struct {
+	Main struct {
+		TempC     float64 `json:"temp"`
+		FeelsLike float64 `json:"feels_like"`
+		Humidity  float64 `json:"humidity"`
+		Pressure  float64 `json:"pressure"`
+	} `json:"main"`
+	Wind struct {
+		SpeedKPH float64 `json:"speed"`
+	} `json:"wind"`
+	Clouds struct {
+		All float64 `json:"all"`
+	} `json:"clouds"`
+}
+
+func (s *OpenWeatherMapService) GetWeatherFromCity(ctx context.Context, city string) (*WeatherResponse, error) {
+	ctx, span := tracer().Start(ctx, "OpenWeatherMapService.GetWeatherFromCity")
+	defer span.End()
+	span.SetAttributes(attribute.String("city", city))
+
+	apiKey := os.Getenv("OPENWEATHERMAP_API_KEY")
+	if apiKey == "" {
+		err := errors.New("openweathermap api key not found")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	lat, lon, err := s.geocodeCity(ctx, city, apiKey)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	weather, err := s.currentWeather(ctx, lat, lon, apiKey)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	return weather, nil
+}
+
+// GetWeatherByCoordinates queries current weather directly from lat/lon,
+// bypassing geocoding.
+func (s *OpenWeatherMapService) GetWeatherByCoordinates(ctx context.Context, lat, lon float64) (*WeatherResponse, error) {
+	ctx, span := tracer().Start(ctx, "OpenWeatherMapService.GetWeatherByCoordinates")
+	defer span.End()
+	span.SetAttributes(attribute.Float64("lat", lat), attribute.Float64("lon", lon))
+
+	apiKey := os.Getenv("OPENWEATHERMAP_API_KEY")
+	if apiKey == "" {
+		err := errors.New("openweathermap api key not found")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	weather, err := s.currentWeather(ctx, lat, lon, apiKey)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	return weather, nil
+}
+
+func (s *OpenWeatherMapService) geocodeCity(ctx context.Context, city, apiKey string) (lat, lon float64, err error) {
+	u := fmt.Sprintf("%s/geo/1.0/direct?q=%s&limit=1&appid=%s", baseURLopenWeatherMap, url.QueryEscape(city), apiKey)
+	body, _, err := doGet(ctx, u)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var results []openWeatherMapGeoResult
+	if err = json.Unmarshal(body, &results); err != nil {
+		return 0, 0, err
+	}
+	if len(results) == 0 {
+		return 0, 0, ErrCanNotFindZipCode
+	}
+
+	return results[0].Lat, results[0].Lon, nil
+}
+
+func (s *OpenWeatherMapService) currentWeather(ctx context.Context, lat, lon float64, apiKey string) (*WeatherResponse, error) {
+	u := fmt.Sprintf("%s/data/2.5/weather?lat=%f&lon=%f&units=metric&appid=%s", baseURLopenWeatherMap, lat, lon, apiKey)
+	body, _, err := doGet(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	var owm openWeatherMapWeatherResponse
+	if err = json.Unmarshal(body, &owm); err != nil {
+		return nil, err
+	}
+
+	var w WeatherResponse
+	w.Current.TempC = owm.Main.TempC
+	w.Current.FeelsLikeC = owm.Main.FeelsLike
+	w.Current.Humidity = owm.Main.Humidity
+	w.Current.PressureMB = owm.Main.Pressure
+	w.Current.WindKPH = owm.Wind.SpeedKPH
+	w.Current.CloudPct = owm.Clouds.All
+	return &w, nil
+}
+
+// doGet issues a traced GET request and returns the response body and status code.
+func doGet(ctx context.Context, url string) ([]byte, int, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp.StatusCode, err
+	}
+	return body, resp.StatusCode, nil
+}
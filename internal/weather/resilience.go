@@ -0,0 +1,178 @@
+package weather
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sony/gobreaker"
+	"golang.org/x/time/rate"
+)
+
+// ErrUpstreamUnavailable is returned once a circuit breaker has tripped for
+// an upstream dependency, instead of letting every caller keep retrying it.
+var ErrUpstreamUnavailable = errors.New("upstream service unavailable")
+
+var (
+	breakersMu sync.RWMutex
+	breakers   = map[string]*gobreaker.CircuitBreaker{}
+)
+
+const (
+	defaultBreakerFailureThreshold = 5
+	defaultBreakerCooldown         = 30 * time.Second
+)
+
+// newBreaker builds a circuit breaker that trips after threshold consecutive
+// failures and allows a single half-open probe after cooldown, and registers
+// it so HealthzHandler can report its state.
+func newBreaker(name string, threshold uint32, cooldown time.Duration) *gobreaker.CircuitBreaker {
+	cb := gobreaker.NewCircuitBreaker(gobreaker.Settings{
+		Name:        name,
+		MaxRequests: 1,
+		Timeout:     cooldown,
+		ReadyToTrip: func(counts gobreaker.Counts) bool {
+			return counts.ConsecutiveFailures >= threshold
+		},
+	})
+
+	breakersMu.Lock()
+	breakers[name] = cb
+	breakersMu.Unlock()
+
+	return cb
+}
+
+// breakerStateString maps gobreaker's State to the lowercase strings
+// HealthzHandler reports.
+func breakerStateString(s gobreaker.State) string {
+	switch s {
+	case gobreaker.StateClosed:
+		return "closed"
+	case gobreaker.StateHalfOpen:
+		return "half-open"
+	case gobreaker.StateOpen:
+		return "open"
+	default:
+		return "unknown"
+	}
+}
+
+// rateFromEnv reads a requests-per-second limit from the named env var,
+// falling back to fallback if unset or invalid.
+func rateFromEnv(key string, fallback float64) rate.Limit {
+	if v := os.Getenv(key); v != "" {
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return rate.Limit(f)
+		}
+	}
+	return rate.Limit(fallback)
+}
+
+// rpsEnvKey derives the env var used to configure a backend's rate limit
+// from its breaker name, e.g. "viacep" -> "VIACEP_RPS".
+func rpsEnvKey(name string) string {
+	return strings.ToUpper(name) + "_RPS"
+}
+
+// ResilientZipCodeService wraps a ZipCodeService with a token-bucket rate
+// limiter and a circuit breaker, so a struggling upstream doesn't get
+// hammered by every request once it starts failing. name identifies the
+// wrapped backend (e.g. "viacep", "zippopotamus"): it's used as the
+// /healthz breaker name and to derive the backend's <NAME>_RPS env var, so
+// each backend gets independent rate limiting and failure isolation.
+type ResilientZipCodeService struct {
+	inner   ZipCodeService
+	limiter *rate.Limiter
+	breaker *gobreaker.CircuitBreaker
+}
+
+func NewResilientZipCodeService(name string, fallbackRPS float64, inner ZipCodeService) *ResilientZipCodeService {
+	return &ResilientZipCodeService{
+		inner:   inner,
+		limiter: rate.NewLimiter(rateFromEnv(rpsEnvKey(name), fallbackRPS), 1),
+		breaker: newBreaker(name, defaultBreakerFailureThreshold, defaultBreakerCooldown),
+	}
+}
+
+func (s *ResilientZipCodeService) GetLocation(ctx context.Context, zipCode, country string) (*LocationResponse, error) {
+	if err := s.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	result, err := s.breaker.Execute(func() (interface{}, error) {
+		return s.inner.GetLocation(ctx, zipCode, country)
+	})
+	if err != nil {
+		if errors.Is(err, gobreaker.ErrOpenState) || errors.Is(err, gobreaker.ErrTooManyRequests) {
+			return nil, ErrUpstreamUnavailable
+		}
+		return nil, err
+	}
+	return result.(*LocationResponse), nil
+}
+
+// ResilientWeatherService wraps a WeatherService with a token-bucket rate
+// limiter and a circuit breaker, guarding against the wrapped provider's
+// request quota and occasional stalls. name identifies the wrapped provider
+// (e.g. "weatherapi", "openweathermap"): it's used as the /healthz breaker
+// name and to derive the provider's <NAME>_RPS env var, so /healthz reports
+// the actual configured provider's state rather than a hardcoded one.
+type ResilientWeatherService struct {
+	inner   WeatherService
+	limiter *rate.Limiter
+	breaker *gobreaker.CircuitBreaker
+}
+
+func NewResilientWeatherService(name string, fallbackRPS float64, inner WeatherService) *ResilientWeatherService {
+	return &ResilientWeatherService{
+		inner:   inner,
+		limiter: rate.NewLimiter(rateFromEnv(rpsEnvKey(name), fallbackRPS), 1),
+		breaker: newBreaker(name, defaultBreakerFailureThreshold, defaultBreakerCooldown),
+	}
+}
+
+func (s *ResilientWeatherService) GetWeatherFromCity(ctx context.Context, city string) (*WeatherResponse, error) {
+	if err := s.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	result, err := s.breaker.Execute(func() (interface{}, error) {
+		return s.inner.GetWeatherFromCity(ctx, city)
+	})
+	if err != nil {
+		if errors.Is(err, gobreaker.ErrOpenState) || errors.Is(err, gobreaker.ErrTooManyRequests) {
+			return nil, ErrUpstreamUnavailable
+		}
+		return nil, err
+	}
+	return result.(*WeatherResponse), nil
+}
+
+// GetWeatherByCoordinates forwards to the inner service if it supports
+// coordinate lookups, under the same rate limiter and breaker.
+func (s *ResilientWeatherService) GetWeatherByCoordinates(ctx context.Context, lat, lon float64) (*WeatherResponse, error) {
+	coordService, ok := s.inner.(CoordinateWeatherService)
+	if !ok {
+		return nil, ErrCoordinatesNotSupported
+	}
+
+	if err := s.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	result, err := s.breaker.Execute(func() (interface{}, error) {
+		return coordService.GetWeatherByCoordinates(ctx, lat, lon)
+	})
+	if err != nil {
+		if errors.Is(err, gobreaker.ErrOpenState) || errors.Is(err, gobreaker.ErrTooManyRequests) {
+			return nil, ErrUpstreamUnavailable
+		}
+		return nil, err
+	}
+	return result.(*WeatherResponse), nil
+}
@@ -0,0 +1,128 @@
+package weather
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/time/rate"
+)
+
+// noLimit builds a limiter that never blocks, so breaker behavior can be
+// tested without waiting on the rate limiter.
+func noLimit() *rate.Limiter {
+	return rate.NewLimiter(rate.Inf, 1)
+}
+
+func TestResilientZipCodeService_OpensAfterConsecutiveFailures(t *testing.T) {
+	mockZipService := new(MockZipCodeService)
+	testZipCode := "12345678"
+	mockZipService.On("GetLocation", mock.Anything, testZipCode, "BR").
+		Return(&LocationResponse{}, ErrCanNotFindZipCode).Times(3)
+
+	service := &ResilientZipCodeService{
+		inner:   mockZipService,
+		limiter: noLimit(),
+		breaker: newBreaker("test-viacep-open", 3, time.Hour),
+	}
+
+	for i := 0; i < 3; i++ {
+		_, err := service.GetLocation(context.Background(), testZipCode, "BR")
+		assert.ErrorIs(t, err, ErrCanNotFindZipCode)
+	}
+
+	_, err := service.GetLocation(context.Background(), testZipCode, "BR")
+	assert.ErrorIs(t, err, ErrUpstreamUnavailable)
+
+	mockZipService.AssertExpectations(t)
+}
+
+func TestResilientZipCodeService_HalfOpensAfterCooldown(t *testing.T) {
+	mockZipService := new(MockZipCodeService)
+	testZipCode := "12345678"
+	expectedLocation := &LocationResponse{City: "TestCity"}
+	mockZipService.On("GetLocation", mock.Anything, testZipCode, "BR").
+		Return(&LocationResponse{}, ErrCanNotFindZipCode).Once()
+	mockZipService.On("GetLocation", mock.Anything, testZipCode, "BR").
+		Return(expectedLocation, nil).Once()
+
+	service := &ResilientZipCodeService{
+		inner:   mockZipService,
+		limiter: noLimit(),
+		breaker: newBreaker("test-viacep-halfopen", 1, 5*time.Millisecond),
+	}
+
+	_, err := service.GetLocation(context.Background(), testZipCode, "BR")
+	assert.ErrorIs(t, err, ErrCanNotFindZipCode)
+
+	_, err = service.GetLocation(context.Background(), testZipCode, "BR")
+	assert.ErrorIs(t, err, ErrUpstreamUnavailable)
+
+	time.Sleep(10 * time.Millisecond)
+
+	location, err := service.GetLocation(context.Background(), testZipCode, "BR")
+	assert.NoError(t, err)
+	assert.Equal(t, expectedLocation, location)
+
+	mockZipService.AssertExpectations(t)
+}
+
+func TestResilientWeatherService_OpensAfterConsecutiveFailures(t *testing.T) {
+	mockWeatherService := new(MockWeatherService)
+	testCity := "TestCity"
+	mockWeatherService.On("GetWeatherFromCity", mock.Anything, testCity).
+		Return(&WeatherResponse{}, ErrCanNotFindZipCode).Times(2)
+
+	service := &ResilientWeatherService{
+		inner:   mockWeatherService,
+		limiter: noLimit(),
+		breaker: newBreaker("test-weatherapi-open", 2, time.Hour),
+	}
+
+	for i := 0; i < 2; i++ {
+		_, err := service.GetWeatherFromCity(context.Background(), testCity)
+		assert.ErrorIs(t, err, ErrCanNotFindZipCode)
+	}
+
+	_, err := service.GetWeatherFromCity(context.Background(), testCity)
+	assert.ErrorIs(t, err, ErrUpstreamUnavailable)
+
+	mockWeatherService.AssertExpectations(t)
+}
+
+func TestResilientWeatherService_CoordinatesNotSupported(t *testing.T) {
+	service := &ResilientWeatherService{
+		inner:   &unsupportedWeatherService{},
+		limiter: noLimit(),
+		breaker: newBreaker("test-weatherapi-coords", 5, time.Hour),
+	}
+
+	_, err := service.GetWeatherByCoordinates(context.Background(), -23.5505, -46.6333)
+	assert.ErrorIs(t, err, ErrCoordinatesNotSupported)
+}
+
+func TestBreakerStateString_Closed(t *testing.T) {
+	cb := newBreaker("test-state-closed", 5, time.Hour)
+	assert.Equal(t, "closed", breakerStateString(cb.State()))
+}
+
+func TestHealthzHandler_ReportsBreakerStates(t *testing.T) {
+	newBreaker("test-healthz", 5, time.Hour)
+
+	req, err := http.NewRequest("GET", "/healthz", nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	HealthzHandler().ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var response map[string]string
+	assert.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+	assert.Equal(t, "closed", response["test-healthz"])
+}
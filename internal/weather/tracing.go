@@ -0,0 +1,33 @@
+package weather
+
+import (
+	"context"
+	"log/slog"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracer looks up the package's tracer against the current global
+// TracerProvider on every call, rather than caching a single Tracer obtained
+// at package init. otel's global Tracer only rebinds its delegate once per
+// process the first time a provider is registered, so a package-level var
+// created before any provider is set would permanently latch onto whichever
+// provider happened to be installed first (e.g. in tests, across packages).
+func tracer() trace.Tracer {
+	return otel.Tracer("github.com/fbonareis/goexpert-deploy-cloud-run/internal/weather")
+}
+
+// logFromContext returns the default slog logger enriched with the
+// trace/span IDs carried by ctx, if any, so logs can be correlated with
+// traces in the OTLP backend.
+func logFromContext(ctx context.Context) *slog.Logger {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return slog.Default()
+	}
+	return slog.Default().With(
+		slog.String("trace_id", sc.TraceID().String()),
+		slog.String("span_id", sc.SpanID().String()),
+	)
+}
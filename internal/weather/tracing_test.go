@@ -0,0 +1,90 @@
+package weather
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func withRecordedSpans(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	prevTP := otel.GetTracerProvider()
+	otel.SetTracerProvider(tp)
+	t.Cleanup(func() { otel.SetTracerProvider(prevTP) })
+
+	return recorder
+}
+
+func TestGetWeather_RecordsRootSpanWithAttributes(t *testing.T) {
+	recorder := withRecordedSpans(t)
+
+	mockZipService := new(MockZipCodeService)
+	mockWeatherService := new(MockWeatherService)
+	testZipCode := "12345678"
+	testCity := "TestCity"
+
+	mockZipService.On("GetLocation", mock.Anything, testZipCode, "BR").Return(&LocationResponse{City: testCity}, nil)
+	expectedWeather := &WeatherResponse{}
+	expectedWeather.Current.TempC = 25.0
+	mockWeatherService.On("GetWeatherFromCity", mock.Anything, testCity).Return(expectedWeather, nil)
+
+	_, err := GetWeather(context.Background(), mockZipService, mockWeatherService, testZipCode, "BR")
+	assert.NoError(t, err)
+
+	spans := recorder.Ended()
+	assert.Len(t, spans, 1)
+
+	span := spans[0]
+	assert.Equal(t, "GetWeather", span.Name())
+
+	attrs := span.Attributes()
+	assert.Contains(t, attrs, attribute.String("zipcode", testZipCode))
+	assert.Contains(t, attrs, attribute.String("country", "BR"))
+	assert.Contains(t, attrs, attribute.String("city", testCity))
+}
+
+func TestCreateHandler_SpanIsChildOfRequest(t *testing.T) {
+	recorder := withRecordedSpans(t)
+
+	mockZipService := new(MockZipCodeService)
+	mockWeatherService := new(MockWeatherService)
+	testZipCode := "12345678"
+
+	mockZipService.On("GetLocation", mock.Anything, testZipCode, "BR").Return(&LocationResponse{}, ErrInvalidZipCode)
+
+	req, err := http.NewRequest("GET", "/weather?zipcode="+testZipCode, nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	CreateHandler(mockZipService, mockWeatherService).ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+
+	spans := recorder.Ended()
+	assert.Len(t, spans, 2)
+
+	var handlerSpan, getWeatherSpan sdktrace.ReadOnlySpan
+	for _, span := range spans {
+		switch span.Name() {
+		case "CreateHandler":
+			handlerSpan = span
+		case "GetWeather":
+			getWeatherSpan = span
+		}
+	}
+
+	assert.NotNil(t, handlerSpan)
+	assert.NotNil(t, getWeatherSpan)
+	assert.Equal(t, handlerSpan.SpanContext().SpanID(), getWeatherSpan.Parent().SpanID())
+}
@@ -0,0 +1,330 @@
+// Package weather holds the domain logic shared by every transport (HTTP,
+// gRPC, ...) that exposes weather-by-zipcode lookups.
+package weather
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"go.opentelemetry.io/otel/attribute"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	baseURLweatherAPI = "http://api.weatherapi.com/v1"
+	baseURLviaCEP     = "http://viacep.com.br"
+)
+
+var (
+	ErrInvalidZipCode          = errors.New("invalid zipcode")
+	ErrCanNotFindZipCode       = errors.New("can not find zipcode")
+	ErrInvalidCoordinates      = errors.New("invalid lat/lon coordinates")
+	ErrCoordinatesNotSupported = errors.New("weather provider does not support coordinate lookups")
+)
+
+type (
+	LocationResponse struct {
+		City string `json:"localidade"`
+		Erro bool   `json:"erro"`
+	}
+	WeatherResponse struct {
+		Current struct {
+			TempC      float64 `json:"temp_c"`
+			TempF      float64 `json:"temp_f"`
+			FeelsLikeC float64 `json:"feelslike_c,omitempty"`
+			Humidity   float64 `json:"humidity,omitempty"`
+			PressureMB float64 `json:"pressure_mb,omitempty"`
+			WindKPH    float64 `json:"wind_kph,omitempty"`
+			CloudPct   float64 `json:"cloud,omitempty"`
+		} `json:"current"`
+	}
+	LocationWeatherResponse struct {
+		TempC      float64 `json:"temp_C"`
+		TempF      float64 `json:"temp_F"`
+		TempK      float64 `json:"temp_K"`
+		FeelsLikeC float64 `json:"feels_like_C,omitempty"`
+		Humidity   float64 `json:"humidity,omitempty"`
+		PressureMB float64 `json:"pressure_mb,omitempty"`
+		WindKPH    float64 `json:"wind_kph,omitempty"`
+		CloudPct   float64 `json:"cloud_pct,omitempty"`
+	}
+)
+
+func (w *WeatherResponse) GetTempF() float64 {
+	return roundFloat(w.Current.TempC*1.8+32, 2)
+}
+func (w *WeatherResponse) GetTempK() float64 {
+	return roundFloat(w.Current.TempC+273, 2)
+}
+
+type (
+	ZipCodeService interface {
+		GetLocation(ctx context.Context, zipCode, country string) (*LocationResponse, error)
+	}
+	WeatherService interface {
+		GetWeatherFromCity(ctx context.Context, city string) (*WeatherResponse, error)
+	}
+	// CoordinateWeatherService is implemented by providers that can serve
+	// current conditions directly from coordinates, bypassing geocoding.
+	CoordinateWeatherService interface {
+		GetWeatherByCoordinates(ctx context.Context, lat, lon float64) (*WeatherResponse, error)
+	}
+)
+
+type RealZipCodeService struct{}
+
+type RealWeatherService struct{}
+
+func init() {
+	RegisterWeatherProvider("weatherapi", func() WeatherService {
+		return &RealWeatherService{}
+	})
+}
+
+// GetLocation resolves a Brazilian CEP via ViaCEP. country is accepted for
+// ZipCodeService compatibility but ignored: RealZipCodeService only serves BR.
+func (s *RealZipCodeService) GetLocation(ctx context.Context, zipCode, country string) (*LocationResponse, error) {
+	ctx, span := tracer().Start(ctx, "RealZipCodeService.GetLocation")
+	defer span.End()
+	span.SetAttributes(attribute.String("zipcode", zipCode), attribute.String("country", "BR"))
+
+	if !validateZipCode("BR", zipCode) {
+		span.RecordError(ErrInvalidZipCode)
+		return nil, ErrInvalidZipCode
+	}
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/ws/%s/json", baseURLviaCEP, zipCode), nil)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	defer resp.Body.Close()
+	span.SetAttributes(attribute.Int("http.status_code", resp.StatusCode))
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	var l LocationResponse
+	if err = json.Unmarshal(body, &l); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	return &l, nil
+}
+
+func removeAccents(s string) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	output, _, e := transform.String(t, s)
+	if e != nil {
+		panic(e)
+	}
+	return output
+}
+
+func (s *RealWeatherService) GetWeatherFromCity(ctx context.Context, city string) (*WeatherResponse, error) {
+	ctx, span := tracer().Start(ctx, "RealWeatherService.GetWeatherFromCity")
+	defer span.End()
+	span.SetAttributes(attribute.String("city", city))
+
+	apiKey := os.Getenv("WEATHER_API_KEY")
+	if apiKey == "" {
+		err := errors.New("weather api key not found")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	c := strings.ReplaceAll(removeAccents(city), " ", "%20")
+
+	body, statusCode, err := doGet(ctx, fmt.Sprintf("%s/current.json?key=%s&q=%s&aqi=no", baseURLweatherAPI, apiKey, c))
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	span.SetAttributes(attribute.Int("http.status_code", statusCode))
+
+	var w WeatherResponse
+	if err = json.Unmarshal(body, &w); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	return &w, nil
+}
+
+// GetWeatherByCoordinates queries WeatherAPI directly with "lat,lon" as the
+// q parameter, bypassing geocoding.
+func (s *RealWeatherService) GetWeatherByCoordinates(ctx context.Context, lat, lon float64) (*WeatherResponse, error) {
+	ctx, span := tracer().Start(ctx, "RealWeatherService.GetWeatherByCoordinates")
+	defer span.End()
+	span.SetAttributes(attribute.Float64("lat", lat), attribute.Float64("lon", lon))
+
+	apiKey := os.Getenv("WEATHER_API_KEY")
+	if apiKey == "" {
+		err := errors.New("weather api key not found")
+		span.RecordError(err)
+		return nil, err
+	}
+
+	body, statusCode, err := doGet(ctx, fmt.Sprintf("%s/current.json?key=%s&q=%f,%f&aqi=no", baseURLweatherAPI, apiKey, lat, lon))
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	span.SetAttributes(attribute.Int("http.status_code", statusCode))
+
+	var w WeatherResponse
+	if err = json.Unmarshal(body, &w); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	return &w, nil
+}
+
+// CreateHandler builds the HTTP handler for the /weather endpoint. It accepts
+// either a zipcode (optionally with a country query param, default BR) or a
+// lat+lon pair that bypasses geocoding entirely.
+func CreateHandler(zipCodeService ZipCodeService, weatherService WeatherService) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := tracer().Start(r.Context(), "CreateHandler")
+		defer span.End()
+
+		query := r.URL.Query()
+		if latParam, lonParam := query.Get("lat"), query.Get("lon"); latParam != "" || lonParam != "" {
+			response, err := getWeatherByCoordinates(ctx, weatherService, latParam, lonParam)
+			if err != nil {
+				span.RecordError(err)
+				logFromContext(ctx).Error("get weather by coordinates failed", "lat", latParam, "lon", lonParam, "error", err)
+				handleError(w, err)
+				return
+			}
+
+			logFromContext(ctx).Info("get weather by coordinates succeeded", "lat", latParam, "lon", lonParam)
+			if err := json.NewEncoder(w).Encode(response); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+			}
+			return
+		}
+
+		zipCode := query.Get("zipcode")
+		country := query.Get("country")
+		if country == "" {
+			country = "BR"
+		}
+		span.SetAttributes(attribute.String("zipcode", zipCode), attribute.String("country", country))
+
+		response, err := GetWeather(ctx, zipCodeService, weatherService, zipCode, country)
+		if err != nil {
+			span.RecordError(err)
+			logFromContext(ctx).Error("get weather failed", "zipcode", zipCode, "country", country, "error", err)
+			handleError(w, err)
+			return
+		}
+
+		logFromContext(ctx).Info("get weather succeeded", "zipcode", zipCode, "country", country)
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
+
+// getWeatherByCoordinates parses lat/lon and queries weatherService directly,
+// requiring it to implement CoordinateWeatherService.
+func getWeatherByCoordinates(ctx context.Context, weatherService WeatherService, latParam, lonParam string) (*LocationWeatherResponse, error) {
+	coordService, ok := weatherService.(CoordinateWeatherService)
+	if !ok {
+		return nil, ErrCoordinatesNotSupported
+	}
+
+	lat, err := strconv.ParseFloat(latParam, 64)
+	if err != nil {
+		return nil, ErrInvalidCoordinates
+	}
+	lon, err := strconv.ParseFloat(lonParam, 64)
+	if err != nil {
+		return nil, ErrInvalidCoordinates
+	}
+
+	weather, err := coordService.GetWeatherByCoordinates(ctx, lat, lon)
+	if err != nil {
+		return nil, err
+	}
+	return toLocationWeatherResponse(weather), nil
+}
+
+func handleError(w http.ResponseWriter, err error) {
+	var status int
+
+	switch {
+	case errors.Is(err, ErrInvalidZipCode), errors.Is(err, ErrInvalidCoordinates):
+		status = http.StatusUnprocessableEntity
+	case errors.Is(err, ErrCanNotFindZipCode):
+		status = http.StatusNotFound
+	case errors.Is(err, ErrCoordinatesNotSupported):
+		status = http.StatusNotImplemented
+	case errors.Is(err, ErrUpstreamUnavailable):
+		status = http.StatusServiceUnavailable
+	default:
+		status = http.StatusInternalServerError
+	}
+
+	http.Error(w, err.Error(), status)
+}
+
+func GetWeather(ctx context.Context, zipCodeService ZipCodeService, weatherService WeatherService, zipCode, country string) (*LocationWeatherResponse, error) {
+	ctx, span := tracer().Start(ctx, "GetWeather")
+	defer span.End()
+	span.SetAttributes(attribute.String("zipcode", zipCode), attribute.String("country", country))
+
+	location, err := zipCodeService.GetLocation(ctx, zipCode, country)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if location.Erro {
+		span.RecordError(ErrCanNotFindZipCode)
+		return nil, ErrCanNotFindZipCode
+	}
+	span.SetAttributes(attribute.String("city", location.City))
+
+	weather, err := weatherService.GetWeatherFromCity(ctx, location.City)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return toLocationWeatherResponse(weather), nil
+}
+
+func toLocationWeatherResponse(weather *WeatherResponse) *LocationWeatherResponse {
+	return &LocationWeatherResponse{
+		TempC:      weather.Current.TempC,
+		TempF:      weather.GetTempF(),
+		TempK:      weather.GetTempK(),
+		FeelsLikeC: weather.Current.FeelsLikeC,
+		Humidity:   weather.Current.Humidity,
+		PressureMB: weather.Current.PressureMB,
+		WindKPH:    weather.Current.WindKPH,
+		CloudPct:   weather.Current.CloudPct,
+	}
+}
+
+func roundFloat(val float64, precision uint) float64 {
+	ratio := math.Pow(10, float64(precision))
+	return math.Round(val*ratio) / ratio
+}
@@ -0,0 +1,25 @@
+package weather
+
+import "fmt"
+
+// WeatherServiceFactory builds a new WeatherService instance for a registered provider.
+type WeatherServiceFactory func() WeatherService
+
+var weatherProviders = map[string]WeatherServiceFactory{}
+
+// RegisterWeatherProvider makes a WeatherService implementation available under name,
+// so it can be selected at runtime (e.g. via the WEATHER_PROVIDER env var) without
+// editing main.go.
+func RegisterWeatherProvider(name string, factory WeatherServiceFactory) {
+	weatherProviders[name] = factory
+}
+
+// NewWeatherService looks up a provider registered with RegisterWeatherProvider and
+// returns a new WeatherService built from its factory.
+func NewWeatherService(name string) (WeatherService, error) {
+	factory, ok := weatherProviders[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown weather provider: %s", name)
+	}
+	return factory(), nil
+}
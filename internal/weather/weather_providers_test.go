@@ -0,0 +1,41 @@
+package weather
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterAndNewWeatherProvider(t *testing.T) {
+	RegisterWeatherProvider("mock-provider", func() WeatherService {
+		return new(MockWeatherService)
+	})
+
+	service, err := NewWeatherService("mock-provider")
+	assert.NoError(t, err)
+	assert.IsType(t, &MockWeatherService{}, service)
+}
+
+func TestNewWeatherServiceUnknownProvider(t *testing.T) {
+	_, err := NewWeatherService("does-not-exist")
+	assert.Error(t, err)
+}
+
+func TestKnownWeatherProviders(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider string
+		wantType WeatherService
+	}{
+		{name: "weatherapi", provider: "weatherapi", wantType: &RealWeatherService{}},
+		{name: "openweathermap", provider: "openweathermap", wantType: &OpenWeatherMapService{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, err := NewWeatherService(tt.provider)
+			assert.NoError(t, err)
+			assert.IsType(t, tt.wantType, service)
+		})
+	}
+}
@@ -1,6 +1,7 @@
-package main
+package weather
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -15,8 +16,8 @@ type MockZipCodeService struct {
 	mock.Mock
 }
 
-func (m *MockZipCodeService) GetLocation(zipCode string) (*LocationResponse, error) {
-	args := m.Called(zipCode)
+func (m *MockZipCodeService) GetLocation(ctx context.Context, zipCode, country string) (*LocationResponse, error) {
+	args := m.Called(ctx, zipCode, country)
 	return args.Get(0).(*LocationResponse), args.Error(1)
 }
 
@@ -24,8 +25,13 @@ type MockWeatherService struct {
 	mock.Mock
 }
 
-func (m *MockWeatherService) GetWeatherFromCity(city string) (*WeatherResponse, error) {
-	args := m.Called(city)
+func (m *MockWeatherService) GetWeatherFromCity(ctx context.Context, city string) (*WeatherResponse, error) {
+	args := m.Called(ctx, city)
+	return args.Get(0).(*WeatherResponse), args.Error(1)
+}
+
+func (m *MockWeatherService) GetWeatherByCoordinates(ctx context.Context, lat, lon float64) (*WeatherResponse, error) {
+	args := m.Called(ctx, lat, lon)
 	return args.Get(0).(*WeatherResponse), args.Error(1)
 }
 
@@ -33,9 +39,9 @@ func TestGetLocation(t *testing.T) {
 	mockZipService := new(MockZipCodeService)
 	testZipCode := "12345678"
 	expectedLocation := &LocationResponse{City: "TestCity", Erro: false}
-	mockZipService.On("GetLocation", testZipCode).Return(expectedLocation, nil)
+	mockZipService.On("GetLocation", mock.Anything, testZipCode, "BR").Return(expectedLocation, nil)
 
-	location, err := mockZipService.GetLocation(testZipCode)
+	location, err := mockZipService.GetLocation(context.Background(), testZipCode, "BR")
 	assert.NoError(t, err)
 	assert.Equal(t, expectedLocation, location)
 	mockZipService.AssertExpectations(t)
@@ -47,9 +53,9 @@ func TestGetWeatherFromCity(t *testing.T) {
 	expectedWeather := &WeatherResponse{}
 	expectedWeather.Current.TempC = 25.0
 	expectedWeather.Current.TempF = 77.0
-	mockWeatherService.On("GetWeatherFromCity", testCity).Return(expectedWeather, nil)
+	mockWeatherService.On("GetWeatherFromCity", mock.Anything, testCity).Return(expectedWeather, nil)
 
-	weather, err := mockWeatherService.GetWeatherFromCity(testCity)
+	weather, err := mockWeatherService.GetWeatherFromCity(context.Background(), testCity)
 	assert.NoError(t, err)
 	assert.Equal(t, expectedWeather, weather)
 	mockWeatherService.AssertExpectations(t)
@@ -65,8 +71,8 @@ func TestGetWeather(t *testing.T) {
 	expectedWeather.Current.TempC = 25.0
 	expectedWeather.Current.TempF = 77.0
 
-	mockZipService.On("GetLocation", testZipCode).Return(expectedLocation, nil)
-	mockWeatherService.On("GetWeatherFromCity", testCity).Return(expectedWeather, nil)
+	mockZipService.On("GetLocation", mock.Anything, testZipCode, "BR").Return(expectedLocation, nil)
+	mockWeatherService.On("GetWeatherFromCity", mock.Anything, testCity).Return(expectedWeather, nil)
 
 	expectedLocationWeather := &LocationWeatherResponse{
 		TempC: 25.0,
@@ -74,7 +80,7 @@ func TestGetWeather(t *testing.T) {
 		TempK: 298,
 	}
 
-	locationWeather, err := GetWeather(mockZipService, mockWeatherService, testZipCode)
+	locationWeather, err := GetWeather(context.Background(), mockZipService, mockWeatherService, testZipCode, "BR")
 	assert.NoError(t, err)
 	assert.Equal(t, expectedLocationWeather, locationWeather)
 	mockZipService.AssertExpectations(t)
@@ -86,9 +92,9 @@ func TestGetWeatherInvalidZipCode(t *testing.T) {
 	mockWeatherService := new(MockWeatherService)
 	invalidZipCode := "123"
 
-	mockZipService.On("GetLocation", invalidZipCode).Return(&LocationResponse{}, ErrInvalidZipCode)
+	mockZipService.On("GetLocation", mock.Anything, invalidZipCode, "BR").Return(&LocationResponse{}, ErrInvalidZipCode)
 
-	_, err := GetWeather(mockZipService, mockWeatherService, invalidZipCode)
+	_, err := GetWeather(context.Background(), mockZipService, mockWeatherService, invalidZipCode, "BR")
 	assert.Error(t, err)
 	assert.Equal(t, ErrInvalidZipCode, err)
 	mockZipService.AssertExpectations(t)
@@ -104,14 +110,14 @@ func TestCreateHandler_Endpoint_Success(t *testing.T) {
 	expectedWeather.Current.TempC = 25.0
 	expectedWeather.Current.TempF = 77.0
 
-	mockZipService.On("GetLocation", testZipCode).Return(expectedLocation, nil)
-	mockWeatherService.On("GetWeatherFromCity", testCity).Return(expectedWeather, nil)
+	mockZipService.On("GetLocation", mock.Anything, testZipCode, "BR").Return(expectedLocation, nil)
+	mockWeatherService.On("GetWeatherFromCity", mock.Anything, testCity).Return(expectedWeather, nil)
 
 	req, err := http.NewRequest("GET", "/weather?zipcode=12345678", nil)
 	assert.NoError(t, err)
 
 	rr := httptest.NewRecorder()
-	handler := createHandler(mockZipService, mockWeatherService)
+	handler := CreateHandler(mockZipService, mockWeatherService)
 	handler.ServeHTTP(rr, req)
 
 	assert.Equal(t, http.StatusOK, rr.Code)
@@ -136,13 +142,13 @@ func TestCreateHandler_Endpoint_NotFound(t *testing.T) {
 	mockWeatherService := new(MockWeatherService)
 	testZipCode := "12345678"
 	expectedLocation := &LocationResponse{Erro: true}
-	mockZipService.On("GetLocation", testZipCode).Return(expectedLocation, nil)
+	mockZipService.On("GetLocation", mock.Anything, testZipCode, "BR").Return(expectedLocation, nil)
 
 	req, err := http.NewRequest("GET", fmt.Sprintf("/weather?zipcode=%s", testZipCode), nil)
 	assert.NoError(t, err)
 
 	rr := httptest.NewRecorder()
-	handler := createHandler(mockZipService, mockWeatherService)
+	handler := CreateHandler(mockZipService, mockWeatherService)
 	handler.ServeHTTP(rr, req)
 
 	assert.Equal(t, http.StatusNotFound, rr.Code)
@@ -151,17 +157,65 @@ func TestCreateHandler_Endpoint_NotFound(t *testing.T) {
 	mockWeatherService.AssertExpectations(t)
 }
 
+func TestCreateHandler_Endpoint_Coordinates(t *testing.T) {
+	mockZipService := new(MockZipCodeService)
+	mockWeatherService := new(MockWeatherService)
+	expectedWeather := &WeatherResponse{}
+	expectedWeather.Current.TempC = 25.0
+	expectedWeather.Current.TempF = 77.0
+
+	mockWeatherService.On("GetWeatherByCoordinates", mock.Anything, -23.5505, -46.6333).Return(expectedWeather, nil)
+
+	req, err := http.NewRequest("GET", "/weather?lat=-23.5505&lon=-46.6333", nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	handler := CreateHandler(mockZipService, mockWeatherService)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var response LocationWeatherResponse
+	err = json.NewDecoder(rr.Body).Decode(&response)
+	assert.NoError(t, err)
+
+	expectedResponse := LocationWeatherResponse{
+		TempC: 25.0,
+		TempF: 77.0,
+		TempK: 298,
+	}
+	assert.Equal(t, expectedResponse, response)
+
+	mockZipService.AssertExpectations(t)
+	mockWeatherService.AssertExpectations(t)
+}
+
+func TestCreateHandler_Endpoint_InvalidCoordinates(t *testing.T) {
+	mockZipService := new(MockZipCodeService)
+	mockWeatherService := new(MockWeatherService)
+
+	req, err := http.NewRequest("GET", "/weather?lat=notanumber&lon=-46.6333", nil)
+	assert.NoError(t, err)
+
+	rr := httptest.NewRecorder()
+	handler := CreateHandler(mockZipService, mockWeatherService)
+	handler.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	assert.Contains(t, rr.Body.String(), "invalid lat/lon coordinates")
+}
+
 func TestCreateHandler_Endpoint_UnprocessableContent(t *testing.T) {
 	mockZipService := new(MockZipCodeService)
 	mockWeatherService := new(MockWeatherService)
 	testZipCode := "123"
-	mockZipService.On("GetLocation", testZipCode).Return(&LocationResponse{}, ErrInvalidZipCode)
+	mockZipService.On("GetLocation", mock.Anything, testZipCode, "BR").Return(&LocationResponse{}, ErrInvalidZipCode)
 
 	req, err := http.NewRequest("GET", fmt.Sprintf("/weather?zipcode=%s", testZipCode), nil)
 	assert.NoError(t, err)
 
 	rr := httptest.NewRecorder()
-	handler := createHandler(mockZipService, mockWeatherService)
+	handler := CreateHandler(mockZipService, mockWeatherService)
 	handler.ServeHTTP(rr, req)
 
 	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
@@ -0,0 +1,105 @@
+package weather
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const baseURLzippopotamus = "http://api.zippopotam.us"
+
+// CountryRules defines per-country zipcode validation, keyed by ISO 3166-1
+// alpha-2 country code, so adding a country doesn't require touching the
+// lookup logic itself.
+var CountryRules = map[string]*regexp.Regexp{
+	"BR": regexp.MustCompile(`^\d{8}$`),
+	"US": regexp.MustCompile(`^\d{5}(-\d{4})?$`),
+	"CA": regexp.MustCompile(`^[A-Za-z]\d[A-Za-z] ?\d[A-Za-z]\d$`),
+	"GB": regexp.MustCompile(`^[A-Za-z0-9]{2,4} ?[A-Za-z0-9]{3}$`),
+}
+
+// validateZipCode applies the CountryRules entry for country, if any. A
+// country with no registered rule only requires a non-empty zipCode.
+func validateZipCode(country, zipCode string) bool {
+	rule, ok := CountryRules[strings.ToUpper(country)]
+	if !ok {
+		return zipCode != ""
+	}
+	return rule.MatchString(zipCode)
+}
+
+// MultiCountryZipCodeService dispatches GetLocation to a per-country
+// ZipCodeService: ViaCEP for BR, Zippopotam.us for everything else. Each
+// backend carries its own rate limiter and circuit breaker, so a burst of
+// failures against one upstream can't trip the other's breaker or starve it
+// of its rate-limit budget.
+type MultiCountryZipCodeService struct {
+	brService   ZipCodeService
+	intlService ZipCodeService
+}
+
+func NewMultiCountryZipCodeService() *MultiCountryZipCodeService {
+	return &MultiCountryZipCodeService{
+		brService:   NewResilientZipCodeService("viacep", 10, &RealZipCodeService{}),
+		intlService: NewResilientZipCodeService("zippopotamus", 10, &ZippopotamusZipCodeService{}),
+	}
+}
+
+func (s *MultiCountryZipCodeService) GetLocation(ctx context.Context, zipCode, country string) (*LocationResponse, error) {
+	if country == "" {
+		country = "BR"
+	}
+	if strings.EqualFold(country, "BR") {
+		return s.brService.GetLocation(ctx, zipCode, country)
+	}
+	return s.intlService.GetLocation(ctx, zipCode, country)
+}
+
+// ZippopotamusZipCodeService resolves non-Brazilian zipcodes via
+// Zippopotam.us, which needs no API key.
+type ZippopotamusZipCodeService struct{}
+
+type zippopotamusResponse struct {
+	Places []struct {
+		PlaceName string `json:"place name"`
+	} `json:"places"`
+}
+
+func (s *ZippopotamusZipCodeService) GetLocation(ctx context.Context, zipCode, country string) (*LocationResponse, error) {
+	ctx, span := tracer().Start(ctx, "ZippopotamusZipCodeService.GetLocation")
+	defer span.End()
+	span.SetAttributes(attribute.String("zipcode", zipCode), attribute.String("country", country))
+
+	if !validateZipCode(country, zipCode) {
+		span.RecordError(ErrInvalidZipCode)
+		return nil, ErrInvalidZipCode
+	}
+
+	u := fmt.Sprintf("%s/%s/%s", baseURLzippopotamus, strings.ToLower(country), zipCode)
+	body, statusCode, err := doGet(ctx, u)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	span.SetAttributes(attribute.Int("http.status_code", statusCode))
+
+	if statusCode == http.StatusNotFound {
+		return &LocationResponse{Erro: true}, nil
+	}
+
+	var result zippopotamusResponse
+	if err = json.Unmarshal(body, &result); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if len(result.Places) == 0 {
+		return &LocationResponse{Erro: true}, nil
+	}
+
+	return &LocationResponse{City: result.Places[0].PlaceName}, nil
+}
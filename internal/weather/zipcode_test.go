@@ -0,0 +1,66 @@
+package weather
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestValidateZipCode(t *testing.T) {
+	tests := []struct {
+		name    string
+		country string
+		zipCode string
+		want    bool
+	}{
+		{name: "valid BR", country: "BR", zipCode: "12345678", want: true},
+		{name: "invalid BR", country: "BR", zipCode: "123", want: false},
+		{name: "valid US", country: "US", zipCode: "90210", want: true},
+		{name: "valid US with +4", country: "US", zipCode: "90210-1234", want: true},
+		{name: "invalid US", country: "US", zipCode: "ABCDE", want: false},
+		{name: "unknown country accepts non-empty", country: "ZZ", zipCode: "anything", want: true},
+		{name: "unknown country rejects empty", country: "ZZ", zipCode: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, validateZipCode(tt.country, tt.zipCode))
+		})
+	}
+}
+
+func TestMultiCountryZipCodeService_DispatchesByCountry(t *testing.T) {
+	mockBR := new(MockZipCodeService)
+	mockBR.On("GetLocation", mock.Anything, "12345678", "BR").Return(&LocationResponse{City: "BRCity"}, nil)
+
+	mockIntl := new(MockZipCodeService)
+	mockIntl.On("GetLocation", mock.Anything, "90210", "US").Return(&LocationResponse{City: "USCity"}, nil)
+
+	service := &MultiCountryZipCodeService{brService: mockBR, intlService: mockIntl}
+
+	location, err := service.GetLocation(context.Background(), "12345678", "BR")
+	assert.NoError(t, err)
+	assert.Equal(t, "BRCity", location.City)
+
+	location, err = service.GetLocation(context.Background(), "90210", "US")
+	assert.NoError(t, err)
+	assert.Equal(t, "USCity", location.City)
+
+	mockBR.AssertExpectations(t)
+	mockIntl.AssertExpectations(t)
+}
+
+func TestMultiCountryZipCodeService_DefaultsToBR(t *testing.T) {
+	mockBR := new(MockZipCodeService)
+	mockBR.On("GetLocation", mock.Anything, "12345678", "BR").Return(&LocationResponse{City: "BRCity"}, nil)
+
+	service := &MultiCountryZipCodeService{brService: mockBR, intlService: new(MockZipCodeService)}
+
+	location, err := service.GetLocation(context.Background(), "12345678", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "BRCity", location.City)
+
+	mockBR.AssertExpectations(t)
+}
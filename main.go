@@ -1,190 +1,109 @@
 package main
 
 import (
-	"encoding/json"
-	"errors"
-	"fmt"
-	"io"
-	"math"
+	"context"
+	"log/slog"
+	"net"
 	"net/http"
 	"os"
-	"strings"
-	"unicode"
+	"time"
 
-	"golang.org/x/text/runes"
-	"golang.org/x/text/transform"
-	"golang.org/x/text/unicode/norm"
-)
-
-const (
-	baseURLweatherAPI = "http://api.weatherapi.com/v1"
-	baseURLviaCEP     = "http://viacep.com.br"
-)
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"google.golang.org/grpc"
 
-var (
-	ErrInvalidZipCode    = errors.New("invalid zipcode")
-	ErrCanNotFindZipCode = errors.New("can not find zipcode")
+	"github.com/fbonareis/goexpert-deploy-cloud-run/internal/weather"
+	"github.com/fbonareis/goexpert-deploy-cloud-run/proto/weatherpb"
 )
 
-type (
-	LocationResponse struct {
-		City string `json:"localidade"`
-		Erro bool   `json:"erro"`
-	}
-	WeatherResponse struct {
-		Current struct {
-			TempC float64 `json:"temp_c"`
-			TempF float64 `json:"temp_f"`
-		} `json:"current"`
-	}
-	LocationWeatherResponse struct {
-		TempC float64 `json:"temp_C"`
-		TempF float64 `json:"temp_F"`
-		TempK float64 `json:"temp_K"`
-	}
-)
-
-func (w *WeatherResponse) GetTempF() float64 {
-	return roundFloat(w.Current.TempC*1.8+32, 2)
-}
-func (w *WeatherResponse) GetTempK() float64 {
-	return roundFloat(w.Current.TempC+273, 2)
-}
-
-type (
-	ZipCodeService interface {
-		GetLocation(zipCode string) (*LocationResponse, error)
-	}
-	WeatherService interface {
-		GetWeatherFromCity(city string) (*WeatherResponse, error)
-	}
+const (
+	zipCodeCacheTTL = 24 * time.Hour
+	weatherCacheTTL = 10 * time.Minute
 )
 
-type RealZipCodeService struct{}
-
-type RealWeatherService struct{}
+func main() {
+	slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stdout, nil)))
 
-func (s *RealZipCodeService) GetLocation(zipCode string) (*LocationResponse, error) {
-	if len(zipCode) != 8 {
-		return nil, ErrInvalidZipCode
-	}
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/ws/%s/json", baseURLviaCEP, zipCode), nil)
-	if err != nil {
-		return nil, err
-	}
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-	body, err := io.ReadAll(resp.Body)
+	shutdownTracer, err := initTracer()
 	if err != nil {
-		return nil, err
+		panic(err)
 	}
-	var l LocationResponse
-	if err = json.Unmarshal(body, &l); err != nil {
-		return nil, err
-	}
-	return &l, nil
-}
+	defer shutdownTracer(context.Background())
 
-func removeAccents(s string) string {
-	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
-	output, _, e := transform.String(t, s)
-	if e != nil {
-		panic(e)
+	provider := os.Getenv("WEATHER_PROVIDER")
+	if provider == "" {
+		provider = "weatherapi"
 	}
-	return output
-}
 
-func (s *RealWeatherService) GetWeatherFromCity(city string) (*WeatherResponse, error) {
-	apiKey := os.Getenv("WEATHER_API_KEY")
-	if apiKey == "" {
-		return nil, errors.New("weather api key not found")
-	}
-
-	c := strings.ReplaceAll(removeAccents(city), " ", "%20")
-
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/current.json?key=%s&q=%s&aqi=no", baseURLweatherAPI, apiKey, c), nil)
-	if err != nil {
-		return nil, err
-	}
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-	body, err := io.ReadAll(resp.Body)
+	weatherService, err := weather.NewWeatherService(provider)
 	if err != nil {
-		return nil, err
+		panic(err)
 	}
-	var w WeatherResponse
-	if err = json.Unmarshal(body, &w); err != nil {
-		return nil, err
-	}
-	return &w, nil
-}
-
-func main() {
-	zipCodeService := &RealZipCodeService{}
-	weatherService := &RealWeatherService{}
-	http.HandleFunc("/weather", createHandler(zipCodeService, weatherService))
-	http.ListenAndServe(":8080", nil)
-}
+	weatherService = weather.NewCachedWeatherService(weather.NewResilientWeatherService(provider, 5, weatherService), weatherCacheTTL)
 
-func createHandler(zipCodeService ZipCodeService, weatherService WeatherService) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		zipCode := r.URL.Query().Get("zipcode")
-		response, err := GetWeather(zipCodeService, weatherService, zipCode)
+	var zipCodeService weather.ZipCodeService = weather.NewCachedZipCodeService(
+		weather.NewMultiCountryZipCodeService(),
+		zipCodeCacheTTL,
+	)
 
-		if err != nil {
-			handleError(w, err)
-			return
+	go func() {
+		if err := startGRPCServer(":50051", zipCodeService, weatherService); err != nil {
+			panic(err)
 		}
+	}()
 
-		if err := json.NewEncoder(w).Encode(response); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		}
-	}
+	http.HandleFunc("/weather", weather.CreateHandler(zipCodeService, weatherService))
+	http.HandleFunc("/metrics", weather.MetricsHandler())
+	http.HandleFunc("/healthz", weather.HealthzHandler())
+	http.ListenAndServe(":8080", nil)
 }
 
-func handleError(w http.ResponseWriter, err error) {
-	var status int
+// initTracer installs a global OTLP/gRPC tracer provider, configured through
+// the standard OTEL_EXPORTER_OTLP_ENDPOINT env var, and returns its shutdown
+// function.
+func initTracer() (func(context.Context) error, error) {
+	ctx := context.Background()
 
-	switch {
-	case errors.Is(err, ErrInvalidZipCode):
-		status = http.StatusUnprocessableEntity
-	case errors.Is(err, ErrCanNotFindZipCode):
-		status = http.StatusNotFound
-	default:
-		status = http.StatusInternalServerError
+	endpoint := os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT")
+	if endpoint == "" {
+		endpoint = "localhost:4317"
 	}
 
-	http.Error(w, err.Error(), status)
-}
-
-func GetWeather(zipCodeService ZipCodeService, weatherService WeatherService, zipCode string) (*LocationWeatherResponse, error) {
-	location, err := zipCodeService.GetLocation(zipCode)
+	exporter, err := otlptracegrpc.New(ctx,
+		otlptracegrpc.WithEndpoint(endpoint),
+		otlptracegrpc.WithInsecure(),
+	)
 	if err != nil {
 		return nil, err
 	}
-	if location.Erro {
-		return nil, ErrCanNotFindZipCode
-	}
 
-	weather, err := weatherService.GetWeatherFromCity(location.City)
+	res, err := resource.New(ctx, resource.WithAttributes(
+		semconv.ServiceName("goexpert-deploy-cloud-run"),
+	))
 	if err != nil {
 		return nil, err
 	}
 
-	return &LocationWeatherResponse{
-		TempC: weather.Current.TempC,
-		TempF: weather.GetTempF(),
-		TempK: weather.GetTempK(),
-	}, nil
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(tp)
+
+	return tp.Shutdown, nil
 }
 
-func roundFloat(val float64, precision uint) float64 {
-	ratio := math.Pow(10, float64(precision))
-	return math.Round(val*ratio) / ratio
+func startGRPCServer(addr string, zipCodeService weather.ZipCodeService, weatherService weather.WeatherService) error {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	grpcServer := grpc.NewServer()
+	weatherpb.RegisterWeatherServiceServer(grpcServer, weather.NewWeatherGRPCServer(zipCodeService, weatherService))
+
+	return grpcServer.Serve(lis)
 }
@@ -0,0 +1,115 @@
+// Hand-maintained stub for the message types declared in weather.proto.
+//
+// This is NOT protoc-gen-go output — no protoc toolchain was available to
+// generate it, so it's missing the raw file descriptor and ProtoReflect()
+// that real generated code carries, and it implements just enough of the
+// legacy github.com/golang/protobuf Message interface (Reset/String/
+// ProtoMessage) for gRPC's codec to marshal these types. If weather.proto
+// changes, update this file by hand to match, or replace it by running
+// `protoc --go_out=. --go-grpc_out=. proto/weather.proto` once a real
+// protoc-gen-go is available.
+
+package weatherpb
+
+import (
+	proto "github.com/golang/protobuf/proto"
+)
+
+type RequestZip struct {
+	ZipCode string `protobuf:"bytes,1,opt,name=zip_code,json=zipCode,proto3" json:"zip_code,omitempty"`
+	Country string `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty"`
+}
+
+func (m *RequestZip) Reset()         { *m = RequestZip{} }
+func (m *RequestZip) String() string { return proto.CompactTextString(m) }
+func (*RequestZip) ProtoMessage()    {}
+
+func (m *RequestZip) GetZipCode() string {
+	if m != nil {
+		return m.ZipCode
+	}
+	return ""
+}
+
+func (m *RequestZip) GetCountry() string {
+	if m != nil {
+		return m.Country
+	}
+	return ""
+}
+
+type LocationWeatherResponse struct {
+	TempC      float64 `protobuf:"fixed64,1,opt,name=temp_c,json=tempC,proto3" json:"temp_c,omitempty"`
+	TempF      float64 `protobuf:"fixed64,2,opt,name=temp_f,json=tempF,proto3" json:"temp_f,omitempty"`
+	TempK      float64 `protobuf:"fixed64,3,opt,name=temp_k,json=tempK,proto3" json:"temp_k,omitempty"`
+	FeelsLikeC float64 `protobuf:"fixed64,4,opt,name=feels_like_c,json=feelsLikeC,proto3" json:"feels_like_c,omitempty"`
+	Humidity   float64 `protobuf:"fixed64,5,opt,name=humidity,proto3" json:"humidity,omitempty"`
+	PressureMB float64 `protobuf:"fixed64,6,opt,name=pressure_mb,json=pressureMb,proto3" json:"pressure_mb,omitempty"`
+	WindKPH    float64 `protobuf:"fixed64,7,opt,name=wind_kph,json=windKph,proto3" json:"wind_kph,omitempty"`
+	CloudPct   float64 `protobuf:"fixed64,8,opt,name=cloud_pct,json=cloudPct,proto3" json:"cloud_pct,omitempty"`
+}
+
+func (m *LocationWeatherResponse) Reset()         { *m = LocationWeatherResponse{} }
+func (m *LocationWeatherResponse) String() string { return proto.CompactTextString(m) }
+func (*LocationWeatherResponse) ProtoMessage()    {}
+
+func (m *LocationWeatherResponse) GetTempC() float64 {
+	if m != nil {
+		return m.TempC
+	}
+	return 0
+}
+
+func (m *LocationWeatherResponse) GetTempF() float64 {
+	if m != nil {
+		return m.TempF
+	}
+	return 0
+}
+
+func (m *LocationWeatherResponse) GetTempK() float64 {
+	if m != nil {
+		return m.TempK
+	}
+	return 0
+}
+
+func (m *LocationWeatherResponse) GetFeelsLikeC() float64 {
+	if m != nil {
+		return m.FeelsLikeC
+	}
+	return 0
+}
+
+func (m *LocationWeatherResponse) GetHumidity() float64 {
+	if m != nil {
+		return m.Humidity
+	}
+	return 0
+}
+
+func (m *LocationWeatherResponse) GetPressureMB() float64 {
+	if m != nil {
+		return m.PressureMB
+	}
+	return 0
+}
+
+func (m *LocationWeatherResponse) GetWindKPH() float64 {
+	if m != nil {
+		return m.WindKPH
+	}
+	return 0
+}
+
+func (m *LocationWeatherResponse) GetCloudPct() float64 {
+	if m != nil {
+		return m.CloudPct
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*RequestZip)(nil), "weather.RequestZip")
+	proto.RegisterType((*LocationWeatherResponse)(nil), "weather.LocationWeatherResponse")
+}